@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterConditionReady expresses the validity status of a cluster.
+	ClusterConditionReady = "Ready"
+
+	// ClusterConditionAgentAvailable indicates whether the karmada-agent running inside a
+	// proxy-connected member cluster has registered its connection with the control plane.
+	ClusterConditionAgentAvailable = "AgentAvailable"
+)
+
+// ClusterSyncMode describes how the karmada-controller-manager and karmada-agent
+// cooperate to keep a member cluster's resources and status in sync.
+type ClusterSyncMode string
+
+const (
+	// Push mode means the control plane synchronizes resources and status by dialing the
+	// member cluster's API server directly.
+	Push ClusterSyncMode = "Push"
+	// Pull mode means a karmada-agent running inside the member cluster pulls resources
+	// from, and pushes status to, the control plane.
+	Pull ClusterSyncMode = "Pull"
+)
+
+// ClusterConnectionType describes how the control plane reaches a member cluster's API
+// server. This is independent of ClusterSyncMode: a Pull-mode cluster's karmada-agent
+// typically dials out to the control plane, but Push-mode clusters can also sit behind a
+// proxy/tunnel.
+type ClusterConnectionType string
+
+const (
+	// ConnectionTypeDirect means the control plane dials the member cluster's API server
+	// directly using the credentials in Spec.SecretRef/APIEndpoint.
+	ConnectionTypeDirect ClusterConnectionType = "Direct"
+	// ConnectionTypeProxy means the member cluster is only reachable through an
+	// agent-initiated (proxy/tunnel) connection - e.g. a karmada-agent behind a firewall or
+	// NAT that dials out to the control plane rather than accepting inbound connections.
+	ConnectionTypeProxy ClusterConnectionType = "Proxy"
+)
+
+// Cluster represents the desire state and status of a member cluster.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec defines the desired state of a member cluster.
+type ClusterSpec struct {
+	// SyncMode describes how a cluster synchronizes resources and status with the control
+	// plane.
+	// +kubebuilder:validation:Enum=Push;Pull
+	SyncMode ClusterSyncMode `json:"syncMode,omitempty"`
+
+	// ConnectionType describes how the control plane reaches this cluster's API server.
+	// Defaults to ConnectionTypeDirect when empty.
+	// +kubebuilder:validation:Enum=Direct;Proxy
+	ConnectionType ClusterConnectionType `json:"connectionType,omitempty"`
+}
+
+// ClusterStatus contains the current status of a member cluster.
+type ClusterStatus struct {
+	// KubernetesVersion represents the version of Kubernetes running on the member cluster.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// APIEnablements represents the list of APIs installed in the member cluster.
+	APIEnablements []APIEnablement `json:"apiEnablements,omitempty"`
+
+	// Conditions is an array of current cluster conditions.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// NodeSummary represents the summary of nodes status in the member cluster.
+	NodeSummary NodeSummary `json:"nodeSummary,omitempty"`
+
+	// LastHeartbeatTime is the last time the cluster-status-controller refreshed its health
+	// observation of this cluster (including refreshes that recorded a failure),
+	// independent of whether Ready's status changed. It lets consumers distinguish a stale status (controller not
+	// reporting) from a cluster that is genuinely flapping (LastTransitionTime moving).
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// Reason mirrors the Ready condition's Reason when it's False, for quick diagnosis
+	// without inspecting Conditions.
+	Reason string `json:"reason,omitempty"`
+
+	// Message mirrors the Ready condition's Message when it's False, for quick diagnosis
+	// without inspecting Conditions.
+	Message string `json:"message,omitempty"`
+}
+
+// APIEnablement represents the list of APIs installed in the member cluster.
+type APIEnablement struct {
+	// GroupVersion is the group/version the resources below belong to.
+	GroupVersion string `json:"groupVersion"`
+	// Resources is a list of resources this API group/version provides.
+	Resources []string `json:"resources,omitempty"`
+}
+
+// NodeSummary represents the summary of nodes status in the member cluster.
+type NodeSummary struct {
+	// TotalNum is the total number of nodes in the member cluster.
+	TotalNum int `json:"totalNum,omitempty"`
+	// ReadyNum is the number of ready nodes in the member cluster.
+	ReadyNum int `json:"readyNum,omitempty"`
+	// Allocatable represents the resources of a node that are available for scheduling.
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Used represents the resources of a node that are already requested by scheduled pods.
+	Used corev1.ResourceList `json:"used,omitempty"`
+}
@@ -2,21 +2,22 @@ package status
 
 import (
 	"context"
-	"net/http"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -32,6 +33,21 @@ const (
 	clusterUnhealthy          = "cluster is reachable but health endpoint responded without ok"
 	clusterNotReachableReason = "ClusterNotReachable"
 	clusterNotReachableMsg    = "cluster is not reachable"
+	// clusterAgentUnavailableReason is used when a proxy/agent-based member cluster
+	// has not yet registered its agent connection with the control plane.
+	clusterAgentUnavailableReason = "ClusterAgentUnavailable"
+	clusterAgentUnavailableMsg    = "cluster uses an agent-initiated connection and no agent has connected yet"
+	// clusterRefreshFailedReason is used when refreshClusterHealth could not complete a
+	// health check refresh (e.g. discovery or list calls failed) after the cluster was
+	// found reachable, so the cached observation shouldn't be reported as ready any longer.
+	clusterRefreshFailedReason = "ClusterStatusRefreshFailed"
+	// clusterHeartbeatPersistThreshold bounds how stale a persisted LastHeartbeatTime is
+	// allowed to get when nothing else in the status has changed. Without this, a
+	// heartbeat-only delta would never be written (see updateStatusIfNeeded) and the field
+	// would freeze at the time of the last real status change - defeating the point of
+	// letting lease-less consumers tell "controller stopped reporting" apart from "stable
+	// and healthy."
+	clusterHeartbeatPersistThreshold = time.Minute
 	// clusterStatusRetryInterval specifies the interval between two retries.
 	clusterStatusRetryInterval = 500 * time.Millisecond
 	// clusterStatusRetryTimeout specifies the maximum time to wait for cluster status.
@@ -49,6 +65,47 @@ type ClusterStatusController struct {
 	// If cluster lease feature is not enabled, it is also the frequency that controller posts cluster status
 	// to karmada-apiserver.
 	ClusterStatusUpdateFrequency metav1.Duration
+
+	// ResourceAggregationNodeFilter restricts which nodes are counted towards the
+	// aggregated NodeSummary (TotalNum/ReadyNum/Allocatable/Used), e.g. to exclude
+	// control-plane or tainted nodes from scheduling-relevant totals. A nil selector
+	// matches every node, preserving the previous behavior.
+	ResourceAggregationNodeFilter labels.Selector
+
+	// ReadinessCheckers are the sub-checks run against a member cluster to determine
+	// cluster readiness. If empty, NewDefaultClusterReadinessCheckers(CriticalClusterWorkloads)
+	// is used.
+	ReadinessCheckers []ClusterReadinessChecker
+
+	// CriticalClusterWorkloads is a list of user-supplied workloads whose readiness gates
+	// the ClusterCriticalWorkloadsReady (and therefore overall ClusterReady) condition.
+	CriticalClusterWorkloads []CriticalWorkload
+
+	// ClusterHealthCheckWorkers is the number of background workers refreshing cached
+	// member cluster health observations. Defaults to DefaultClusterHealthCheckWorkers.
+	ClusterHealthCheckWorkers int
+
+	// ClusterHealthCheckPeriod is the minimum interval between two health checks
+	// performed against the same member cluster. Values below MinClusterHealthCheckPeriod
+	// are raised to it.
+	ClusterHealthCheckPeriod metav1.Duration
+
+	// ClusterClientCache provides shared, per-member-cluster Pod informers so that
+	// getNodeSummary can read from an informer cache instead of listing every Pod in
+	// every namespace on each health check.
+	ClusterClientCache *util.ClusterClientCache
+
+	healthCache *clusterHealthCache
+	healthQueue workqueue.RateLimitingInterface
+}
+
+// readinessCheckers returns the configured ReadinessCheckers, falling back to the
+// built-in default set.
+func (c *ClusterStatusController) readinessCheckers() []ClusterReadinessChecker {
+	if len(c.ReadinessCheckers) != 0 {
+		return c.ReadinessCheckers
+	}
+	return NewDefaultClusterReadinessCheckers(c.CriticalClusterWorkloads)
 }
 
 // Reconcile syncs status of the given member cluster.
@@ -83,73 +140,72 @@ func (c *ClusterStatusController) Reconcile(req controllerruntime.Request) (cont
 
 // SetupWithManager creates a controller and register to controller manager.
 func (c *ClusterStatusController) SetupWithManager(mgr controllerruntime.Manager) error {
+	c.healthCache = newClusterHealthCache()
+	c.healthQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), ControllerName+"-health")
+
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		c.runHealthCheckWorkers(ctx.Done())
+		<-ctx.Done()
+		c.healthQueue.ShutDown()
+		return nil
+	})); err != nil {
+		return err
+	}
+
 	return controllerruntime.NewControllerManagedBy(mgr).For(&v1alpha1.Cluster{}).WithEventFilter(c.PredicateFunc).Complete(c)
 }
 
+// syncClusterStatus reads the latest cached health observation for the cluster - produced
+// out-of-band by the health-check worker pool in health_cache.go - and posts it to
+// karmada-apiserver if it has changed. The expensive discovery/list calls against the
+// member cluster never run on this hot path; see refreshClusterHealth.
 func (c *ClusterStatusController) syncClusterStatus(cluster *v1alpha1.Cluster) (controllerruntime.Result, error) {
-	// create a ClusterClient for the given member cluster
-	clusterClient, err := c.ClusterClientSetFunc(cluster, c.Client)
-	if err != nil {
-		klog.Errorf("Failed to create a ClusterClient for the given member cluster: %v, err is : %v", cluster.Name, err)
-		return controllerruntime.Result{Requeue: true}, err
-	}
-
-	var currentClusterStatus = v1alpha1.ClusterStatus{}
-
-	// get the health status of member cluster
-	online, healthy := getClusterHealthStatus(clusterClient)
-
-	// in case of cluster offline, retry a few times to avoid network unstable problems.
-	// Note: retry timeout should not be too long, otherwise will block other cluster reconcile.
-	if !online {
-		err := wait.Poll(clusterStatusRetryInterval, clusterStatusRetryTimeout, func() (done bool, err error) {
-			online, healthy = getClusterHealthStatus(clusterClient)
-			if !online {
-				return false, nil
-			}
-			klog.V(2).Infof("Cluster(%s) back to online after retry.", cluster.Name)
-			return true, nil
-		})
-		// error indicates that retry timeout, update cluster status immediately and return.
-		if err != nil {
-			currentClusterStatus.Conditions = generateReadyCondition(false, false)
-			setTransitionTime(&cluster.Status, &currentClusterStatus)
-			return c.updateStatusIfNeeded(cluster, currentClusterStatus)
-		}
-	}
-
-	clusterVersion, err := getKubernetesVersion(clusterClient)
-	if err != nil {
-		klog.Errorf("Failed to get server version of the member cluster: %v, err is : %v", cluster.Name, err)
-		return controllerruntime.Result{Requeue: true}, err
+	// make sure a background health check is (or will shortly be) scheduled for this cluster
+	c.healthQueue.Add(cluster.Name)
+
+	observation, ok := c.healthCache.get(cluster.Name)
+	if !ok {
+		// no observation yet, e.g. right after controller start; requeue shortly rather
+		// than blocking this reconcile on a synchronous health check.
+		return controllerruntime.Result{RequeueAfter: clusterStatusRetryInterval}, nil
 	}
 
-	// get the list of APIs installed in the member cluster
-	apiEnables, err := getAPIEnablements(clusterClient)
-	if err != nil {
-		klog.Errorf("Failed to get APIs installed in the member cluster: %v, err is : %v", cluster.Name, err)
-		return controllerruntime.Result{Requeue: true}, err
-	}
-
-	// get the summary of nodes status in the member cluster
-	nodeSummary, err := getNodeSummary(clusterClient)
-	if err != nil {
-		klog.Errorf("Failed to get summary of nodes status in the member cluster: %v, err is : %v", cluster.Name, err)
-		return controllerruntime.Result{Requeue: true}, err
+	// Copy the conditions out of the cached observation before setTransitionTime mutates
+	// LastTransitionTime in place - observation.conditions is the backing array shared with
+	// healthCache, which the health-check worker reads and overwrites concurrently.
+	conditions := make([]metav1.Condition, len(observation.conditions))
+	copy(conditions, observation.conditions)
+
+	currentClusterStatus := v1alpha1.ClusterStatus{
+		Conditions:        conditions,
+		KubernetesVersion: observation.kubernetesVersion,
+		APIEnablements:    observation.apiEnablements,
+		NodeSummary:       observation.nodeSummary,
+		LastHeartbeatTime: observation.lastHeartbeatTime,
 	}
-
-	currentClusterStatus.Conditions = generateReadyCondition(online, healthy)
 	setTransitionTime(&cluster.Status, &currentClusterStatus)
-	currentClusterStatus.KubernetesVersion = clusterVersion
-	currentClusterStatus.APIEnablements = apiEnables
-	currentClusterStatus.NodeSummary = nodeSummary
 
 	return c.updateStatusIfNeeded(cluster, currentClusterStatus)
 }
 
-// updateStatusIfNeeded calls updateStatus only if the status of the member cluster is not the same as the old status
+// updateStatusIfNeeded calls updateStatus if the status of the member cluster has changed,
+// or if LastHeartbeatTime alone has gone stale by clusterHeartbeatPersistThreshold.
+// LastHeartbeatTime advances on every background refresh, so it's excluded from
+// the main delta check - otherwise every single reconcile would post a Status().Update -
+// but it still needs to reach karmada-apiserver every so often on its own, or a stable,
+// healthy cluster's persisted heartbeat would freeze forever at the time of its last real
+// status change, making it indistinguishable from a cluster the controller stopped
+// reporting on.
 func (c *ClusterStatusController) updateStatusIfNeeded(cluster *v1alpha1.Cluster, currentClusterStatus v1alpha1.ClusterStatus) (controllerruntime.Result, error) {
-	if !equality.Semantic.DeepEqual(cluster.Status, currentClusterStatus) {
+	oldStatusForCompare := cluster.Status
+	oldStatusForCompare.LastHeartbeatTime = metav1.Time{}
+	newStatusForCompare := currentClusterStatus
+	newStatusForCompare.LastHeartbeatTime = metav1.Time{}
+
+	statusChanged := !equality.Semantic.DeepEqual(oldStatusForCompare, newStatusForCompare)
+	heartbeatStale := currentClusterStatus.LastHeartbeatTime.Sub(cluster.Status.LastHeartbeatTime.Time) >= clusterHeartbeatPersistThreshold
+
+	if statusChanged || heartbeatStale {
 		klog.V(4).Infof("Start to update cluster status: %s", cluster.Name)
 		cluster.Status = currentClusterStatus
 		err := c.Client.Status().Update(context.TODO(), cluster)
@@ -162,30 +218,47 @@ func (c *ClusterStatusController) updateStatusIfNeeded(cluster *v1alpha1.Cluster
 	return controllerruntime.Result{RequeueAfter: c.ClusterStatusUpdateFrequency.Duration}, nil
 }
 
-func getClusterHealthStatus(clusterClient *util.ClusterClient) (online, healthy bool) {
-	healthStatus, err := healthEndpointCheck(clusterClient.KubeClient, "/readyz")
-	if err != nil && healthStatus == http.StatusNotFound {
-		// do health check with healthz endpoint if the readyz endpoint is not installed in member cluster
-		healthStatus, err = healthEndpointCheck(clusterClient.KubeClient, "/healthz")
+// getClusterHealthStatus runs every configured ClusterReadinessChecker against the member
+// cluster and reports whether the cluster is reachable at all (online) alongside the
+// discrete sub-conditions each checker produced. "online" is false only when the API
+// server itself couldn't be reached, as opposed to being reachable but reporting one or
+// more subsystems unready.
+func getClusterHealthStatus(clusterClient *util.ClusterClient, checkers []ClusterReadinessChecker) (online bool, subConditions []metav1.Condition) {
+	online = true
+	subConditions = make([]metav1.Condition, 0, len(checkers))
+
+	for _, checker := range checkers {
+		cond := checker.Check(clusterClient)
+		if cond.Type == clusterAPIReadyConditionType && cond.Reason == clusterNotReachableReason {
+			online = false
+		}
+		subConditions = append(subConditions, cond)
 	}
 
-	if err != nil {
-		klog.Errorf("Failed to do cluster health check for cluster %v, err is : %v ", clusterClient.ClusterName, err)
-		return false, false
-	}
+	return online, subConditions
+}
 
-	if healthStatus != http.StatusOK {
-		klog.Infof("Member cluster %v isn't healthy", clusterClient.ClusterName)
-		return true, false
+// isClusterHealthy reports whether the member cluster's readiness sub-conditions permit the
+// aggregate ClusterReady condition to be true. ClusterAPIReady always gates it. Once an
+// operator opts in by configuring CriticalClusterWorkloads, ClusterCriticalWorkloadsReady
+// gates it too - that's the explicit point of the feature, and the checker reports
+// ConditionTrue trivially when no workloads are configured, so clusters that don't opt in
+// see no behavior change. ClusterCoreDNSReady is deliberately excluded even though it's
+// surfaced in Conditions: it's auto-detected, and gating on it would flip ClusterReady=False
+// for clusters without a literal coredns/kube-dns Deployment (NodeLocalDNS, custom DNS,
+// edge/control-plane-only clusters) and trigger unwanted failover of otherwise-healthy
+// applications.
+func isClusterHealthy(conditions []metav1.Condition) bool {
+	apiReady := meta.FindStatusCondition(conditions, clusterAPIReadyConditionType)
+	if apiReady == nil || apiReady.Status != metav1.ConditionTrue {
+		return false
 	}
 
-	return true, true
-}
+	if workloadsReady := meta.FindStatusCondition(conditions, clusterCriticalWorkloadsReadyConditionType); workloadsReady != nil {
+		return workloadsReady.Status == metav1.ConditionTrue
+	}
 
-func healthEndpointCheck(client *kubernetes.Clientset, path string) (int, error) {
-	var healthStatus int
-	resp := client.DiscoveryClient.RESTClient().Get().AbsPath(path).Do(context.TODO()).StatusCode(&healthStatus)
-	return healthStatus, resp.Error()
+	return true
 }
 
 func generateReadyCondition(online, healthy bool) []metav1.Condition {
@@ -229,15 +302,58 @@ func generateReadyCondition(online, healthy bool) []metav1.Condition {
 	return conditions
 }
 
+// isProxyConnection reports whether the given member cluster is configured to be reached
+// through an agent-initiated (proxy/tunnel) connection, such as the karmada-agent, rather
+// than by the control plane dialing the member API server directly. This is a property of
+// the connection itself (ClusterConnectionType), not of ClusterSyncMode - a Pull-mode
+// cluster's karmada-agent still drives its own status writes and must not be short-circuited
+// here just because SyncMode is Pull.
+func isProxyConnection(cluster *v1alpha1.Cluster) bool {
+	return cluster.Spec.ConnectionType == v1alpha1.ConnectionTypeProxy
+}
+
+// isClusterAgentAvailable reports whether the agent for a proxy-connected member cluster
+// has already registered its availability with the control plane.
+func isClusterAgentAvailable(cluster *v1alpha1.Cluster) bool {
+	return meta.IsStatusConditionTrue(cluster.Status.Conditions, v1alpha1.ClusterConditionAgentAvailable)
+}
+
+// generateAgentUnavailableCondition returns the ClusterReady condition reported when a
+// proxy/agent-based member cluster's agent has not registered a connection yet.
+func generateAgentUnavailableCondition() []metav1.Condition {
+	return []metav1.Condition{
+		{
+			Type:               v1alpha1.ClusterConditionReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             clusterAgentUnavailableReason,
+			Message:            clusterAgentUnavailableMsg,
+			LastTransitionTime: metav1.Now(),
+		},
+	}
+}
+
+// setTransitionTime preserves each condition's LastTransitionTime when its Status hasn't
+// changed since the last observation. newClusterStatus.LastHeartbeatTime is already set by
+// the caller from the health observation's own refresh time - not touched here - so that it
+// reflects when the background worker actually last refreshed this cluster, letting
+// consumers (scheduler, failover controllers) tell a stale status (no recent heartbeat)
+// apart from a cluster that's genuinely flapping (moving LastTransitionTime). This also
+// mirrors the Ready condition's Reason/Message up onto the top-level ClusterStatus fields
+// for quick `kubectl get clusters -o wide` diagnosis.
 func setTransitionTime(oldClusterStatus, newClusterStatus *v1alpha1.ClusterStatus) {
-	// preserve the last transition time if the status of member cluster not changed
-	if util.IsClusterReady(oldClusterStatus) == util.IsClusterReady(newClusterStatus) {
-		if len(oldClusterStatus.Conditions) != 0 {
-			for i := 0; i < len(newClusterStatus.Conditions); i++ {
-				newClusterStatus.Conditions[i].LastTransitionTime = oldClusterStatus.Conditions[0].LastTransitionTime
-			}
+	for i := range newClusterStatus.Conditions {
+		newCond := &newClusterStatus.Conditions[i]
+		if oldCond := meta.FindStatusCondition(oldClusterStatus.Conditions, newCond.Type); oldCond != nil && oldCond.Status == newCond.Status {
+			newCond.LastTransitionTime = oldCond.LastTransitionTime
 		}
 	}
+
+	newClusterStatus.Reason = ""
+	newClusterStatus.Message = ""
+	if readyCond := meta.FindStatusCondition(newClusterStatus.Conditions, v1alpha1.ClusterConditionReady); readyCond != nil && readyCond.Status == metav1.ConditionFalse {
+		newClusterStatus.Reason = readyCond.Reason
+		newClusterStatus.Message = readyCond.Message
+	}
 }
 
 func getKubernetesVersion(clusterClient *util.ClusterClient) (string, error) {
@@ -268,30 +384,32 @@ func getAPIEnablements(clusterClient *util.ClusterClient) ([]v1alpha1.APIEnablem
 	return apiEnablements, nil
 }
 
-func getNodeSummary(clusterClient *util.ClusterClient) (v1alpha1.NodeSummary, error) {
+func getNodeSummary(clusterClient *util.ClusterClient, nodeFilter labels.Selector, podLister corelisters.PodLister) (v1alpha1.NodeSummary, error) {
 	var nodeSummary = v1alpha1.NodeSummary{}
 	nodeList, err := clusterClient.KubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
 		return nodeSummary, err
 	}
 
-	totalNum := len(nodeList.Items)
+	nodes := filterNodesForAggregation(nodeList.Items, nodeFilter)
+
+	totalNum := len(nodes)
 	readyNum := 0
 
-	for _, node := range nodeList.Items {
+	for _, node := range nodes {
 		if getReadyStatusForNode(node.Status) {
 			readyNum++
 		}
 	}
 
-	allocatable := getClusterAllocatable(nodeList)
+	allocatable := getClusterAllocatable(nodes)
 
-	podList, err := clusterClient.KubeClient.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	pods, err := listClusterPods(clusterClient, podLister)
 	if err != nil {
 		return nodeSummary, err
 	}
 
-	usedResource := getUsedResource(podList)
+	usedResource := getUsedResource(pods)
 
 	nodeSummary.TotalNum = totalNum
 	nodeSummary.ReadyNum = readyNum
@@ -301,6 +419,45 @@ func getNodeSummary(clusterClient *util.ClusterClient) (v1alpha1.NodeSummary, er
 	return nodeSummary, nil
 }
 
+// listClusterPods returns every Pod in the member cluster, preferring the shared,
+// informer-backed PodLister for this cluster (if one is available) over a direct
+// list-across-all-namespaces call against the member API server. This is what lets N
+// clusters × M pods avoid hammering every member kube-apiserver on each health check.
+func listClusterPods(clusterClient *util.ClusterClient, podLister corelisters.PodLister) ([]*corev1.Pod, error) {
+	if podLister != nil {
+		return podLister.List(labels.Everything())
+	}
+
+	podList, err := clusterClient.KubeClient.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]*corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pods = append(pods, &podList.Items[i])
+	}
+	return pods, nil
+}
+
+// filterNodesForAggregation returns only the nodes matching the configured
+// ResourceAggregationNodeFilter, so operators can exclude e.g. control-plane or
+// tainted nodes from the reported Allocatable/Used totals. A nil selector matches
+// every node.
+func filterNodesForAggregation(nodes []corev1.Node, nodeFilter labels.Selector) []corev1.Node {
+	if nodeFilter == nil {
+		return nodes
+	}
+
+	filtered := make([]corev1.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if nodeFilter.Matches(labels.Set(node.Labels)) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
 func getReadyStatusForNode(nodeStatus corev1.NodeStatus) bool {
 	for _, condition := range nodeStatus.Conditions {
 		if condition.Type == "Ready" {
@@ -312,9 +469,9 @@ func getReadyStatusForNode(nodeStatus corev1.NodeStatus) bool {
 	return false
 }
 
-func getClusterAllocatable(nodeList *corev1.NodeList) (allocatable corev1.ResourceList) {
+func getClusterAllocatable(nodes []corev1.Node) (allocatable corev1.ResourceList) {
 	allocatable = make(corev1.ResourceList)
-	for _, node := range nodeList.Items {
+	for _, node := range nodes {
 		for key, val := range node.Status.Allocatable {
 			tmpCap, ok := allocatable[key]
 			if ok {
@@ -329,26 +486,22 @@ func getClusterAllocatable(nodeList *corev1.NodeList) (allocatable corev1.Resour
 	return allocatable
 }
 
-func getUsedResource(podList *corev1.PodList) corev1.ResourceList {
-	var requestCPU, requestMem int64
-	for _, pod := range podList.Items {
+func getUsedResource(pods []*corev1.Pod) corev1.ResourceList {
+	used := requestResource{}
+	for _, pod := range pods {
 		if pod.Status.Phase == "Running" {
 			for _, c := range pod.Status.Conditions {
 				if c.Type == "Ready" && c.Status == "True" {
-					podRes := addPodRequestResource(&pod)
-					requestCPU += podRes.MilliCPU
-					requestMem += podRes.Memory
+					used.addResource(addPodRequestResource(pod).ResourceList)
 				}
 			}
 		}
 	}
 
-	usedResource := corev1.ResourceList{
-		corev1.ResourceCPU:    *resource.NewMilliQuantity(requestCPU, resource.DecimalSI),
-		corev1.ResourceMemory: *resource.NewQuantity(requestMem, resource.BinarySI),
+	if used.ResourceList == nil {
+		return corev1.ResourceList{}
 	}
-
-	return usedResource
+	return used.ResourceList
 }
 
 func addPodRequestResource(pod *corev1.Pod) requestResource {
@@ -364,10 +517,12 @@ func calculateResource(pod *corev1.Pod) (res requestResource) {
 	return
 }
 
-// requestResource is a collection of compute resource.
+// requestResource is a collection of compute resources requested by pods, keyed by
+// resource name. Accumulating by name (rather than hard-coding cpu/memory) lets the
+// aggregated NodeSummary also report GPUs (nvidia.com/gpu), hugepages, and Katalyst-style
+// reclaimed/overcommit resources (e.g. katalyst.kubewharf.io/reclaimed_millicpu).
 type requestResource struct {
-	MilliCPU int64
-	Memory   int64
+	ResourceList corev1.ResourceList
 }
 
 func (r *requestResource) addResource(rl corev1.ResourceList) {
@@ -375,14 +530,16 @@ func (r *requestResource) addResource(rl corev1.ResourceList) {
 		return
 	}
 
+	if r.ResourceList == nil {
+		r.ResourceList = make(corev1.ResourceList, len(rl))
+	}
+
 	for rName, rQuant := range rl {
-		switch rName {
-		case corev1.ResourceCPU:
-			r.MilliCPU += rQuant.MilliValue()
-		case corev1.ResourceMemory:
-			r.Memory += rQuant.Value()
-		default:
-			continue
+		if existing, ok := r.ResourceList[rName]; ok {
+			existing.Add(rQuant)
+			r.ResourceList[rName] = existing
+		} else {
+			r.ResourceList[rName] = rQuant.DeepCopy()
 		}
 	}
 }
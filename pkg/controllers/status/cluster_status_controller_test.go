@@ -0,0 +1,71 @@
+package status
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runningReadyPod(name string, requests corev1.ResourceList) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Resources: corev1.ResourceRequirements{Requests: requests},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestGetUsedResourceExtendedResources(t *testing.T) {
+	pods := []*corev1.Pod{
+		runningReadyPod("gpu-workload", corev1.ResourceList{
+			corev1.ResourceCPU:                    resource.MustParse("1"),
+			corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("2"),
+		}),
+		runningReadyPod("hugepages-workload", corev1.ResourceList{
+			corev1.ResourceCPU:                    resource.MustParse("500m"),
+			corev1.ResourceName("hugepages-2Mi"):  resource.MustParse("64Mi"),
+			corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("1"),
+		}),
+	}
+
+	// not running - should not be counted.
+	notRunning := runningReadyPod("pending-workload", corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("10"),
+	})
+	notRunning.Status.Phase = corev1.PodPending
+	pods = append(pods, notRunning)
+
+	used := getUsedResource(pods)
+
+	wantCPU := resource.MustParse("1500m")
+	if got := used[corev1.ResourceCPU]; got.Cmp(wantCPU) != 0 {
+		t.Errorf("cpu = %v, want %v", got.String(), wantCPU.String())
+	}
+
+	wantGPU := resource.MustParse("3")
+	if got := used[corev1.ResourceName("nvidia.com/gpu")]; got.Cmp(wantGPU) != 0 {
+		t.Errorf("nvidia.com/gpu = %v, want %v", got.String(), wantGPU.String())
+	}
+
+	wantHugepages := resource.MustParse("64Mi")
+	if got := used[corev1.ResourceName("hugepages-2Mi")]; got.Cmp(wantHugepages) != 0 {
+		t.Errorf("hugepages-2Mi = %v, want %v", got.String(), wantHugepages.String())
+	}
+}
+
+func TestGetUsedResourceNoPods(t *testing.T) {
+	used := getUsedResource(nil)
+	if len(used) != 0 {
+		t.Errorf("getUsedResource(nil) = %v, want empty", used)
+	}
+}
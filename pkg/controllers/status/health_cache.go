@@ -0,0 +1,247 @@
+package status
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+const (
+	// MinClusterHealthCheckPeriod is the lowest ClusterHealthCheckPeriod the controller
+	// will honor, mirroring Kubeadmiral's MinClusterHealthCheckPeriod guard so a
+	// misconfigured, too-tight period can't hammer member API servers.
+	MinClusterHealthCheckPeriod = 15 * time.Second
+	// DefaultClusterHealthCheckWorkers is used when ClusterHealthCheckWorkers is unset.
+	DefaultClusterHealthCheckWorkers = 1
+)
+
+// clusterHealthObservation is the cached result of the expensive, out-of-band calls
+// against a member cluster: discovery (ServerVersion, API enablement), readiness
+// sub-conditions, and the aggregated node/pod summary. Reconcile reads this instead of
+// issuing these calls itself.
+type clusterHealthObservation struct {
+	conditions        []metav1.Condition
+	kubernetesVersion string
+	apiEnablements    []v1alpha1.APIEnablement
+	nodeSummary       v1alpha1.NodeSummary
+	// lastHeartbeatTime is stamped here, at the moment the background worker actually
+	// refreshes this observation, rather than at Reconcile time - otherwise the heartbeat
+	// would keep advancing off the cache's last value even while no refresh is running.
+	lastHeartbeatTime metav1.Time
+}
+
+// clusterHealthCache caches the latest clusterHealthObservation per member cluster name.
+type clusterHealthCache struct {
+	mu   sync.RWMutex
+	data map[string]clusterHealthObservation
+}
+
+func newClusterHealthCache() *clusterHealthCache {
+	return &clusterHealthCache{data: make(map[string]clusterHealthObservation)}
+}
+
+func (c *clusterHealthCache) get(clusterName string) (clusterHealthObservation, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	obs, ok := c.data[clusterName]
+	return obs, ok
+}
+
+func (c *clusterHealthCache) set(clusterName string, obs clusterHealthObservation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[clusterName] = obs
+}
+
+func (c *clusterHealthCache) delete(clusterName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, clusterName)
+}
+
+// markRefreshFailed records a failed health check refresh in the cache instead of leaving
+// the previous - possibly healthy - observation in place indefinitely. It preserves
+// whatever version/API/node data was already cached (a transient discovery or list failure
+// shouldn't wipe out last-known-good data) while overwriting Conditions so the aggregate
+// ClusterReady condition reflects that this refresh did not succeed.
+func (c *ClusterStatusController) markRefreshFailed(clusterName string, subConditions []metav1.Condition, reason, message string) {
+	obs, _ := c.healthCache.get(clusterName)
+	obs.conditions = append(subConditions, metav1.Condition{
+		Type:               v1alpha1.ClusterConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	obs.lastHeartbeatTime = metav1.Now()
+	c.healthCache.set(clusterName, obs)
+}
+
+// effectiveHealthCheckPeriod returns ClusterHealthCheckPeriod, raised up to
+// MinClusterHealthCheckPeriod if it's unset or configured too low.
+func (c *ClusterStatusController) effectiveHealthCheckPeriod() time.Duration {
+	if c.ClusterHealthCheckPeriod.Duration < MinClusterHealthCheckPeriod {
+		return MinClusterHealthCheckPeriod
+	}
+	return c.ClusterHealthCheckPeriod.Duration
+}
+
+// runHealthCheckWorkers starts the configured number of background workers that refresh
+// cached member cluster health observations, until stopCh is closed.
+func (c *ClusterStatusController) runHealthCheckWorkers(stopCh <-chan struct{}) {
+	workers := c.ClusterHealthCheckWorkers
+	if workers <= 0 {
+		workers = DefaultClusterHealthCheckWorkers
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runHealthCheckWorker, time.Second, stopCh)
+	}
+}
+
+func (c *ClusterStatusController) runHealthCheckWorker() {
+	for c.processNextHealthCheckItem() {
+	}
+}
+
+// processNextHealthCheckItem pops one cluster name off the health queue and refreshes its
+// cached observation, unless a fresh-enough observation already exists (see
+// effectiveHealthCheckPeriod). It reschedules itself after effectiveHealthCheckPeriod on an
+// actual refresh so each cluster is checked on its own period instead of on every Reconcile.
+func (c *ClusterStatusController) processNextHealthCheckItem() bool {
+	key, shutdown := c.healthQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.healthQueue.Done(key)
+
+	clusterName := key.(string)
+
+	cluster := &v1alpha1.Cluster{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKey{Name: clusterName}, cluster); err != nil {
+		if errors.IsNotFound(err) {
+			c.healthCache.delete(clusterName)
+			return true
+		}
+		klog.Errorf("Failed to get cluster %s for health check, requeuing: %v", clusterName, err)
+		c.healthQueue.AddRateLimited(key)
+		return true
+	}
+
+	// syncClusterStatus unconditionally calls healthQueue.Add on every Reconcile so a
+	// background check eventually runs for every cluster, but that means this item can be
+	// re-enqueued (and popped here) far more often than effectiveHealthCheckPeriod - e.g. on
+	// every Status().Update-triggered Reconcile. Skip the expensive refresh when the cached
+	// observation is still within its period; the AddAfter scheduled by the last real
+	// refresh remains pending and will trigger the next one on time.
+	if obs, ok := c.healthCache.get(clusterName); ok && time.Since(obs.lastHeartbeatTime.Time) < c.effectiveHealthCheckPeriod() {
+		return true
+	}
+
+	if !cluster.DeletionTimestamp.IsZero() {
+		c.healthCache.delete(clusterName)
+		return true
+	}
+
+	c.refreshClusterHealth(cluster)
+
+	c.healthQueue.Forget(key)
+	c.healthQueue.AddAfter(key, c.effectiveHealthCheckPeriod())
+	return true
+}
+
+// refreshClusterHealth performs the expensive discovery/list calls against the member
+// cluster (readiness checks, ServerVersion, ServerGroupsAndResources, node/pod
+// aggregation) and stores the result in the health cache for syncClusterStatus to read.
+// It never touches Cluster.Status directly - that's the Reconcile hot path's job.
+func (c *ClusterStatusController) refreshClusterHealth(cluster *v1alpha1.Cluster) {
+	// Clusters that declare an agent-initiated (proxy/tunnel) connection are never dialed
+	// directly by the control plane; short-circuit straight to a not-reachable observation
+	// if the agent hasn't checked in yet.
+	if isProxyConnection(cluster) && !isClusterAgentAvailable(cluster) {
+		klog.Infof("Member cluster(%s) uses an agent-initiated connection but has no available agent yet.", cluster.Name)
+		c.healthCache.set(cluster.Name, clusterHealthObservation{
+			conditions:        generateAgentUnavailableCondition(),
+			lastHeartbeatTime: metav1.Now(),
+		})
+		return
+	}
+
+	clusterClient, err := c.ClusterClientSetFunc(cluster, c.Client)
+	if err != nil {
+		klog.Errorf("Failed to create a ClusterClient for the given member cluster: %v, err is : %v", cluster.Name, err)
+		c.markRefreshFailed(cluster.Name, nil, clusterNotReachableReason, err.Error())
+		return
+	}
+
+	checkers := c.readinessCheckers()
+	online, readinessConditions := getClusterHealthStatus(clusterClient, checkers)
+
+	// in case of cluster offline, retry a few times to avoid network unstable problems.
+	// Note: retry timeout should not be too long, otherwise it'll block this worker from
+	// refreshing other clusters.
+	if !online {
+		pollErr := wait.Poll(clusterStatusRetryInterval, clusterStatusRetryTimeout, func() (done bool, err error) {
+			online, readinessConditions = getClusterHealthStatus(clusterClient, checkers)
+			if !online {
+				return false, nil
+			}
+			klog.V(2).Infof("Cluster(%s) back to online after retry.", cluster.Name)
+			return true, nil
+		})
+		if pollErr != nil {
+			c.healthCache.set(cluster.Name, clusterHealthObservation{
+				conditions:        append(readinessConditions, generateReadyCondition(false, false)...),
+				lastHeartbeatTime: metav1.Now(),
+			})
+			return
+		}
+	}
+
+	clusterVersion, err := getKubernetesVersion(clusterClient)
+	if err != nil {
+		klog.Errorf("Failed to get server version of the member cluster: %v, err is : %v", cluster.Name, err)
+		c.markRefreshFailed(cluster.Name, readinessConditions, clusterRefreshFailedReason, err.Error())
+		return
+	}
+
+	apiEnables, err := getAPIEnablements(clusterClient)
+	if err != nil {
+		klog.Errorf("Failed to get APIs installed in the member cluster: %v, err is : %v", cluster.Name, err)
+		c.markRefreshFailed(cluster.Name, readinessConditions, clusterRefreshFailedReason, err.Error())
+		return
+	}
+
+	var podLister corelisters.PodLister
+	if c.ClusterClientCache != nil {
+		podLister, err = c.ClusterClientCache.PodLister(cluster.Name)
+		if err != nil {
+			klog.Errorf("Failed to get shared pod lister for cluster %v, falling back to a direct list: %v", cluster.Name, err)
+		}
+	}
+
+	nodeSummary, err := getNodeSummary(clusterClient, c.ResourceAggregationNodeFilter, podLister)
+	if err != nil {
+		klog.Errorf("Failed to get summary of nodes status in the member cluster: %v, err is : %v", cluster.Name, err)
+		c.markRefreshFailed(cluster.Name, readinessConditions, clusterRefreshFailedReason, err.Error())
+		return
+	}
+
+	healthy := isClusterHealthy(readinessConditions)
+	c.healthCache.set(cluster.Name, clusterHealthObservation{
+		conditions:        append(readinessConditions, generateReadyCondition(online, healthy)...),
+		kubernetesVersion: clusterVersion,
+		apiEnablements:    apiEnables,
+		nodeSummary:       nodeSummary,
+		lastHeartbeatTime: metav1.Now(),
+	})
+}
@@ -0,0 +1,301 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+const (
+	// clusterAPIReadyConditionType reports whether the member cluster's API server (and a
+	// minimum set of core APIs) are healthy, per the parsed /readyz?verbose output.
+	clusterAPIReadyConditionType = "ClusterAPIReady"
+	clusterAPIReadyReason        = "ClusterAPIReady"
+	clusterAPIReadyMsg           = "API server health check passed and required API groups are present"
+	clusterAPINotReadyReason     = "ClusterAPINotReady"
+	clusterMissingAPIGroupsMsg   = "member cluster is missing required API groups: %s"
+
+	// clusterCoreDNSReadyConditionType reports whether the in-cluster DNS deployment has
+	// caught up to its desired replica count and generation.
+	clusterCoreDNSReadyConditionType = "ClusterCoreDNSReady"
+	clusterCoreDNSReadyReason        = "ClusterCoreDNSReady"
+	clusterCoreDNSNotReadyReason     = "ClusterCoreDNSNotReady"
+	clusterCoreDNSNotFoundMsg        = "neither coredns nor kube-dns deployment was found in kube-system"
+
+	// clusterCriticalWorkloadsReadyConditionType reports whether every user-supplied
+	// critical workload is available.
+	clusterCriticalWorkloadsReadyConditionType = "ClusterCriticalWorkloadsReady"
+	clusterCriticalWorkloadsReadyReason        = "ClusterCriticalWorkloadsReady"
+	clusterCriticalWorkloadsNotReadyReason     = "ClusterCriticalWorkloadsNotReady"
+
+	kubeSystemNamespace = "kube-system"
+)
+
+// requiredAPIGroups are the API groups a member cluster must expose via discovery to be
+// considered ready, in addition to passing /readyz.
+var requiredAPIGroups = []string{"", "apps"}
+
+// CriticalWorkload identifies a namespaced workload whose readiness gates the
+// ClusterCriticalWorkloadsReady condition. Kind is currently one of Deployment,
+// DaemonSet or StatefulSet.
+type CriticalWorkload struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// ClusterReadinessChecker produces a single metav1.Condition describing one readiness
+// signal for a member cluster, modeled on Helm 3.5's kube readiness checks. Every checker's
+// condition is surfaced in Conditions, but only the API server checker's result - and the
+// critical-workloads checker's result, once an operator opts in - feeds the aggregate
+// ClusterReady condition (see isClusterHealthy); CoreDNS is informational only.
+type ClusterReadinessChecker interface {
+	// Check evaluates this checker's readiness signal against the member cluster.
+	Check(clusterClient *util.ClusterClient) metav1.Condition
+}
+
+// NewDefaultClusterReadinessCheckers returns the built-in readiness checkers: API server
+// health plus minimum API groups, CoreDNS/kube-dns availability, and the user-supplied
+// critical workloads (which report ready when none are configured).
+func NewDefaultClusterReadinessCheckers(criticalWorkloads []CriticalWorkload) []ClusterReadinessChecker {
+	return []ClusterReadinessChecker{
+		apiServerReadinessChecker{},
+		coreDNSReadinessChecker{},
+		criticalWorkloadsReadinessChecker{workloads: criticalWorkloads},
+	}
+}
+
+// apiServerReadinessChecker checks /readyz?verbose, surfacing the first failing
+// subsystem line, and confirms the minimum set of required API groups is present.
+type apiServerReadinessChecker struct{}
+
+func (apiServerReadinessChecker) Check(clusterClient *util.ClusterClient) metav1.Condition {
+	cond := metav1.Condition{Type: clusterAPIReadyConditionType, LastTransitionTime: metav1.Now()}
+
+	status, body, err := readyzVerbose(clusterClient)
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = clusterNotReachableReason
+		cond.Message = err.Error()
+		return cond
+	}
+
+	if status != http.StatusOK {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = clusterAPINotReadyReason
+		cond.Message = firstFailingReadyzLine(body)
+		return cond
+	}
+
+	if missing := missingAPIGroups(clusterClient); len(missing) != 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = clusterAPINotReadyReason
+		cond.Message = fmt.Sprintf(clusterMissingAPIGroupsMsg, strings.Join(missing, ","))
+		return cond
+	}
+
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = clusterAPIReadyReason
+	cond.Message = clusterAPIReadyMsg
+	return cond
+}
+
+// readyzVerbose calls /readyz?verbose=true on the member cluster's API server, returning
+// the raw response body so the first failing subsystem can be parsed out of it.
+//
+// client-go's REST client turns any non-2xx response into a non-nil result.Error(), so a
+// reachable-but-unhealthy API server (e.g. 500 from /readyz) looks identical to a
+// completely unreachable one unless we read the status code and body before giving up.
+// Only a transport-level failure (no status code at all) is treated as not-reachable.
+func readyzVerbose(clusterClient *util.ClusterClient) (status int, body []byte, err error) {
+	result := clusterClient.KubeClient.DiscoveryClient.RESTClient().Get().
+		AbsPath("/readyz").
+		Param("verbose", "true").
+		Do(context.TODO())
+
+	result.StatusCode(&status)
+	body, _ = result.Raw()
+	if status == 0 {
+		return 0, nil, result.Error()
+	}
+
+	return status, body, nil
+}
+
+// firstFailingReadyzLine returns the first "[-]..." line of a /readyz?verbose response,
+// which names the specific subsystem that failed, e.g. "[-]poststarthook/foo failed:
+// reason withheld". Falls back to a generic message if no failing line is found.
+func firstFailingReadyzLine(body []byte) string {
+	for _, line := range strings.Split(string(body), "\n") {
+		if strings.HasPrefix(line, "[-]") {
+			return strings.TrimSpace(line)
+		}
+	}
+	return clusterUnhealthy
+}
+
+// missingAPIGroups returns the subset of requiredAPIGroups not present in the member
+// cluster's discovery document.
+func missingAPIGroups(clusterClient *util.ClusterClient) []string {
+	groupList, err := clusterClient.KubeClient.Discovery().ServerGroups()
+	if err != nil {
+		klog.Errorf("Failed to list API groups of cluster %v: %v", clusterClient.ClusterName, err)
+		return requiredAPIGroups
+	}
+
+	present := make(map[string]bool, len(groupList.Groups))
+	for _, group := range groupList.Groups {
+		present[group.Name] = true
+	}
+
+	var missing []string
+	for _, group := range requiredAPIGroups {
+		if !present[group] {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
+
+// coreDNSReadinessChecker checks that the cluster DNS Deployment (coredns, falling back
+// to kube-dns) in kube-system has caught up to its desired replica count and generation.
+type coreDNSReadinessChecker struct{}
+
+var coreDNSDeploymentNames = []string{"coredns", "kube-dns"}
+
+func (coreDNSReadinessChecker) Check(clusterClient *util.ClusterClient) metav1.Condition {
+	cond := metav1.Condition{Type: clusterCoreDNSReadyConditionType, LastTransitionTime: metav1.Now()}
+
+	var lastErr error
+	for _, name := range coreDNSDeploymentNames {
+		deployment, err := clusterClient.KubeClient.AppsV1().Deployments(kubeSystemNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			lastErr = err
+			continue
+		}
+
+		if ready, msg := deploymentReady(deployment); ready {
+			cond.Status = metav1.ConditionTrue
+			cond.Reason = clusterCoreDNSReadyReason
+			cond.Message = fmt.Sprintf("%s is available", name)
+		} else {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = clusterCoreDNSNotReadyReason
+			cond.Message = msg
+		}
+		return cond
+	}
+
+	cond.Status = metav1.ConditionFalse
+	cond.Reason = clusterCoreDNSNotReadyReason
+	if lastErr != nil {
+		cond.Message = lastErr.Error()
+	} else {
+		cond.Message = clusterCoreDNSNotFoundMsg
+	}
+	return cond
+}
+
+func deploymentReady(deployment *appsv1.Deployment) (bool, string) {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, fmt.Sprintf("deployment %s/%s: observed generation %d is behind generation %d", deployment.Namespace, deployment.Name, deployment.Status.ObservedGeneration, deployment.Generation)
+	}
+
+	if deployment.Status.AvailableReplicas < desired {
+		return false, fmt.Sprintf("deployment %s/%s: %d/%d replicas available", deployment.Namespace, deployment.Name, deployment.Status.AvailableReplicas, desired)
+	}
+
+	return true, ""
+}
+
+// criticalWorkloadsReadinessChecker checks that every user-configured critical workload
+// is available. With no workloads configured it reports ready, keeping the condition
+// backward-compatible for clusters that don't opt in.
+type criticalWorkloadsReadinessChecker struct {
+	workloads []CriticalWorkload
+}
+
+func (c criticalWorkloadsReadinessChecker) Check(clusterClient *util.ClusterClient) metav1.Condition {
+	cond := metav1.Condition{Type: clusterCriticalWorkloadsReadyConditionType, LastTransitionTime: metav1.Now()}
+
+	for _, workload := range c.workloads {
+		ready, msg, err := criticalWorkloadReady(clusterClient, workload)
+		if err != nil {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = clusterCriticalWorkloadsNotReadyReason
+			cond.Message = err.Error()
+			return cond
+		}
+		if !ready {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = clusterCriticalWorkloadsNotReadyReason
+			cond.Message = msg
+			return cond
+		}
+	}
+
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = clusterCriticalWorkloadsReadyReason
+	cond.Message = "all critical workloads are available"
+	return cond
+}
+
+// criticalWorkloadReady dispatches to the right workload API based on Kind and reports
+// whether it has caught up to its desired state.
+func criticalWorkloadReady(clusterClient *util.ClusterClient, workload CriticalWorkload) (ready bool, msg string, err error) {
+	switch workload.Kind {
+	case "Deployment":
+		deployment, getErr := clusterClient.KubeClient.AppsV1().Deployments(workload.Namespace).Get(context.TODO(), workload.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return false, "", getErr
+		}
+		ready, msg = deploymentReady(deployment)
+		return ready, msg, nil
+	case "DaemonSet":
+		daemonSet, getErr := clusterClient.KubeClient.AppsV1().DaemonSets(workload.Namespace).Get(context.TODO(), workload.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return false, "", getErr
+		}
+		if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+			return false, fmt.Sprintf("daemonset %s/%s: observed generation %d is behind generation %d", workload.Namespace, workload.Name, daemonSet.Status.ObservedGeneration, daemonSet.Generation), nil
+		}
+		if daemonSet.Status.NumberAvailable < daemonSet.Status.DesiredNumberScheduled {
+			return false, fmt.Sprintf("daemonset %s/%s: %d/%d desired pods available", workload.Namespace, workload.Name, daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled), nil
+		}
+		return true, "", nil
+	case "StatefulSet":
+		statefulSet, getErr := clusterClient.KubeClient.AppsV1().StatefulSets(workload.Namespace).Get(context.TODO(), workload.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return false, "", getErr
+		}
+		desired := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			desired = *statefulSet.Spec.Replicas
+		}
+		if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+			return false, fmt.Sprintf("statefulset %s/%s: observed generation %d is behind generation %d", workload.Namespace, workload.Name, statefulSet.Status.ObservedGeneration, statefulSet.Generation), nil
+		}
+		if statefulSet.Status.AvailableReplicas < desired {
+			return false, fmt.Sprintf("statefulset %s/%s: %d/%d replicas available", workload.Namespace, workload.Name, statefulSet.Status.AvailableReplicas, desired), nil
+		}
+		return true, "", nil
+	default:
+		return false, "", fmt.Errorf("unsupported critical workload kind %q for %s/%s", workload.Kind, workload.Namespace, workload.Name)
+	}
+}
@@ -0,0 +1,209 @@
+package status
+
+import (
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestDeploymentReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		wantReady  bool
+	}{
+		{
+			name: "available replicas meet desired, generation caught up",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, AvailableReplicas: 2},
+			},
+			wantReady: true,
+		},
+		{
+			name: "observed generation behind",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, AvailableReplicas: 2},
+			},
+			wantReady: false,
+		},
+		{
+			name: "available replicas below desired",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns", Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, AvailableReplicas: 1},
+			},
+			wantReady: false,
+		},
+		{
+			name: "nil replicas defaults desired to one",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns", Generation: 1},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, AvailableReplicas: 1},
+			},
+			wantReady: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, msg := deploymentReady(tt.deployment)
+			if ready != tt.wantReady {
+				t.Errorf("deploymentReady() ready = %v, want %v (msg: %q)", ready, tt.wantReady, msg)
+			}
+			if !ready && msg == "" {
+				t.Errorf("deploymentReady() returned not-ready with an empty message")
+			}
+		})
+	}
+}
+
+func TestCriticalWorkloadReady(t *testing.T) {
+	tests := []struct {
+		name      string
+		workload  CriticalWorkload
+		objects   []runtime.Object
+		wantReady bool
+		wantErr   bool
+	}{
+		{
+			name:     "deployment ready",
+			workload: CriticalWorkload{Namespace: "default", Kind: "Deployment", Name: "app"},
+			objects: []runtime.Object{&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app", Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(1)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, AvailableReplicas: 1},
+			}},
+			wantReady: true,
+		},
+		{
+			name:     "daemonset not all desired pods available",
+			workload: CriticalWorkload{Namespace: "default", Kind: "DaemonSet", Name: "ds"},
+			objects: []runtime.Object{&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ds", Generation: 1},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, NumberAvailable: 2},
+			}},
+			wantReady: false,
+		},
+		{
+			name:     "statefulset available replicas below desired",
+			workload: CriticalWorkload{Namespace: "default", Kind: "StatefulSet", Name: "sts"},
+			objects: []runtime.Object{&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sts", Generation: 1},
+				Spec:       appsv1.StatefulSetSpec{Replicas: int32Ptr(3)},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, AvailableReplicas: 2},
+			}},
+			wantReady: false,
+		},
+		{
+			name:     "get error propagates",
+			workload: CriticalWorkload{Namespace: "default", Kind: "Deployment", Name: "missing"},
+			objects:  nil,
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported kind errors",
+			workload: CriticalWorkload{Namespace: "default", Kind: "Job", Name: "job"},
+			objects:  nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterClient := &util.ClusterClient{KubeClient: fake.NewSimpleClientset(tt.objects...)}
+			ready, _, err := criticalWorkloadReady(clusterClient, tt.workload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("criticalWorkloadReady() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && ready != tt.wantReady {
+				t.Errorf("criticalWorkloadReady() ready = %v, want %v", ready, tt.wantReady)
+			}
+		})
+	}
+}
+
+func TestFirstFailingReadyzLine(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want string
+	}{
+		{
+			name: "returns the failing line",
+			body: []byte("[+]ping ok\n[-]poststarthook/foo failed: reason withheld\n[+]shutdown ok\n"),
+			want: "[-]poststarthook/foo failed: reason withheld",
+		},
+		{
+			name: "falls back when no failing line is present",
+			body: []byte("[+]ping ok\n[+]shutdown ok\n"),
+			want: clusterUnhealthy,
+		},
+		{
+			name: "falls back on empty body",
+			body: []byte(""),
+			want: clusterUnhealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firstFailingReadyzLine(tt.body); got != tt.want {
+				t.Errorf("firstFailingReadyzLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissingAPIGroups(t *testing.T) {
+	tests := []struct {
+		name          string
+		groupVersions []string
+		wantMissing   []string
+	}{
+		{
+			name:          "all required groups present",
+			groupVersions: []string{"v1", "apps/v1"},
+			wantMissing:   nil,
+		},
+		{
+			name:          "apps group missing",
+			groupVersions: []string{"v1"},
+			wantMissing:   []string{"apps"},
+		},
+		{
+			name:          "core group missing",
+			groupVersions: []string{"apps/v1"},
+			wantMissing:   []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterClientset := fake.NewSimpleClientset()
+			resources := make([]*metav1.APIResourceList, 0, len(tt.groupVersions))
+			for _, gv := range tt.groupVersions {
+				resources = append(resources, &metav1.APIResourceList{GroupVersion: gv})
+			}
+			clusterClientset.Fake.Resources = resources
+
+			clusterClient := &util.ClusterClient{KubeClient: clusterClientset}
+			got := missingAPIGroups(clusterClient)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.wantMissing) {
+				t.Errorf("missingAPIGroups() = %q, want %q", got, tt.wantMissing)
+			}
+		})
+	}
+}